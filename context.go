@@ -0,0 +1,90 @@
+package Logger
+
+import (
+	"context"
+	"sync"
+)
+
+type ctxKey struct{}
+
+// ContextExtractor pulls well-known request-scoped fields (request_id,
+// trace_id, tenant, user_id, ...) out of a context.Context so the *Ctx
+// logging methods can attach them to the emitted event.
+type ContextExtractor func(ctx context.Context) map[string]any
+
+var ctxExtractor ContextExtractor
+
+// SetContextExtractor registers the function used by DebugfCtx, InfofCtx,
+// WarnfCtx and ErrorfCtx to derive structured fields from a context.Context.
+// Call it once during setup, alongside New.
+func SetContextExtractor(fn ContextExtractor) {
+	ctxExtractor = fn
+}
+
+var (
+	defaultLoggerOnce sync.Once
+	defaultLoggerVal  *Logger
+)
+
+// defaultLogger lazily builds the fallback Logger returned by FromContext,
+// so merely importing this package never forces the process-wide zerolog
+// level via New -> setupLogger's SetGlobalLevel.
+func defaultLogger() *Logger {
+	defaultLoggerOnce.Do(func() {
+		defaultLoggerVal = New(defaultLevel, nil, false)
+	})
+	return defaultLoggerVal
+}
+
+// WithContext returns a copy of ctx carrying l, retrievable later via
+// FromContext.
+func (l *Logger) WithContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxKey{}, l)
+}
+
+// FromContext returns the Logger previously attached to ctx via
+// WithContext, or a default Logger if ctx carries none.
+func FromContext(ctx context.Context) *Logger {
+	if lg, ok := ctx.Value(ctxKey{}).(*Logger); ok {
+		return lg
+	}
+	return defaultLogger()
+}
+
+// With returns a copy of l with fields attached so that they appear on
+// every subsequent Debugf/Infof/Warnf/Errorf/Fatalf call.
+func (l *Logger) With(fields map[string]any) *Logger {
+	ctx := l.logger.With()
+	for k, v := range fields {
+		ctx = ctx.Interface(k, v)
+	}
+	lg := ctx.Logger()
+	return &Logger{logger: &lg, closer: l.closer, pendingErr: l.pendingErr, severityMapping: l.severityMapping}
+}
+
+func (l *Logger) withExtractedFields(ctx context.Context) *Logger {
+	if ctxExtractor == nil {
+		return l
+	}
+	fields := ctxExtractor(ctx)
+	if len(fields) == 0 {
+		return l
+	}
+	return l.With(fields)
+}
+
+func (l *Logger) DebugfCtx(ctx context.Context, message string, args ...any) {
+	l.withExtractedFields(ctx).Debugf(message, args...)
+}
+
+func (l *Logger) InfofCtx(ctx context.Context, message string, args ...any) {
+	l.withExtractedFields(ctx).Infof(message, args...)
+}
+
+func (l *Logger) WarnfCtx(ctx context.Context, message string, args ...any) {
+	l.withExtractedFields(ctx).Warnf(message, args...)
+}
+
+func (l *Logger) ErrorfCtx(ctx context.Context, message string, args ...any) {
+	l.withExtractedFields(ctx).Errorf(message, args...)
+}
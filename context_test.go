@@ -0,0 +1,82 @@
+package Logger
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+func TestWithPreservesPendingErr(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewWithCustomLogger(slog.LevelInfo, nil, func(sfc int) zerolog.Context {
+		return zerolog.New(buf).With()
+	})
+
+	err := errors.New("boom")
+	logger.Err(err).With(map[string]any{"x": 1}).Infof("hi")
+
+	if !strings.Contains(buf.String(), `"error":"boom"`) {
+		t.Fatalf("expected With() to keep the pending error, got %s", buf.String())
+	}
+}
+
+func TestWithPreservesCloser(t *testing.T) {
+	logger := NewWithRotation(RotationConfig{Filename: t.TempDir() + "/test.log", MaxSize: 1})
+
+	child := logger.With(map[string]any{"x": 1})
+	if child.closer == nil {
+		t.Fatal("With() dropped the logger's closer")
+	}
+}
+
+func TestWithContextFromContextRoundTrip(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewWithCustomLogger(slog.LevelInfo, nil, func(sfc int) zerolog.Context {
+		return zerolog.New(buf).With()
+	})
+
+	ctx := logger.WithContext(context.Background())
+	FromContext(ctx).Infof("hi")
+
+	if !strings.Contains(buf.String(), `"message":"hi"`) {
+		t.Fatalf("expected FromContext to return the attached logger, got %s", buf.String())
+	}
+}
+
+func TestFromContextFallsBackToDefault(t *testing.T) {
+	if lg := FromContext(context.Background()); lg == nil {
+		t.Fatal("expected FromContext to return a non-nil default Logger")
+	}
+}
+
+func TestCtxMethodsUseRegisteredExtractor(t *testing.T) {
+	type ctxKeyRequestID struct{}
+
+	prev := ctxExtractor
+	defer func() { ctxExtractor = prev }()
+
+	SetContextExtractor(func(ctx context.Context) map[string]any {
+		id, _ := ctx.Value(ctxKeyRequestID{}).(string)
+		if id == "" {
+			return nil
+		}
+		return map[string]any{"request_id": id}
+	})
+
+	buf := &bytes.Buffer{}
+	logger := NewWithCustomLogger(slog.LevelInfo, nil, func(sfc int) zerolog.Context {
+		return zerolog.New(buf).With()
+	})
+
+	ctx := context.WithValue(context.Background(), ctxKeyRequestID{}, "req-123")
+	logger.InfofCtx(ctx, "hi")
+
+	if !strings.Contains(buf.String(), `"request_id":"req-123"`) {
+		t.Fatalf("expected InfofCtx to attach the extracted field, got %s", buf.String())
+	}
+}
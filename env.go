@@ -0,0 +1,96 @@
+package Logger
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	envLevel  = "LOGGER_LEVEL"
+	envFormat = "LOGGER_FORMAT"
+	envCaller = "LOGGER_CALLER"
+	envOutput = "LOGGER_OUTPUT"
+
+	// levelFatal extends the standard slog levels (which stop at Error) by
+	// one step, following slog's own convention for custom levels.
+	levelFatal slog.Level = slog.LevelError + 4
+)
+
+// NewFromEnv builds a Logger from LOGGER_LEVEL (DEBUG/INFO/WARN/ERROR/
+// FATAL), LOGGER_FORMAT (json/pretty), LOGGER_CALLER (bool) and
+// LOGGER_OUTPUT (stdout/stderr/a file path). Unset variables fall back to
+// the same defaults as New: info level, caller enabled, stdout.
+func NewFromEnv() *Logger {
+	level := levelFromEnv(os.Getenv(envLevel))
+	disableShowCaller := !callerFromEnv(os.Getenv(envCaller))
+	w := outputFromEnv(os.Getenv(envOutput))
+
+	var output io.Writer = w
+	if strings.EqualFold(os.Getenv(envFormat), "pretty") {
+		output = newConsoleWriter(w)
+	}
+	logger := NewWithCustomLogger(level, nil, func(sfc int) zerolog.Context {
+		zc := zerolog.New(output).With().Timestamp()
+		if !disableShowCaller {
+			zc = zc.CallerWithSkipFrameCount(zerolog.CallerSkipFrameCount + sfc)
+		}
+		return zc
+	})
+
+	// Only a file opened by outputFromEnv should be closed by Logger.Close;
+	// stdout/stderr are owned by the process, not the Logger.
+	if w != os.Stdout && w != os.Stderr {
+		logger.closer = w
+	}
+	return logger
+}
+
+func levelFromEnv(v string) slog.Level {
+	switch strings.ToUpper(v) {
+	case "DEBUG":
+		return slog.LevelDebug
+	case "WARN":
+		return slog.LevelWarn
+	case "ERROR":
+		return slog.LevelError
+	case "FATAL":
+		return levelFatal
+	case "INFO":
+		return slog.LevelInfo
+	default:
+		return defaultLevel
+	}
+}
+
+func callerFromEnv(v string) bool {
+	if v == "" {
+		return true
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return true
+	}
+	return b
+}
+
+func outputFromEnv(v string) *os.File {
+	switch strings.ToLower(v) {
+	case "", "stdout":
+		return os.Stdout
+	case "stderr":
+		return os.Stderr
+	default:
+		f, err := os.OpenFile(v, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "zlogger: LOGGER_OUTPUT %q: %v, falling back to stdout\n", v, err)
+			return os.Stdout
+		}
+		return f
+	}
+}
@@ -0,0 +1,81 @@
+package Logger
+
+import (
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLevelFromEnv(t *testing.T) {
+	cases := map[string]slog.Level{
+		"DEBUG": slog.LevelDebug,
+		"warn":  slog.LevelWarn,
+		"Error": slog.LevelError,
+		"FATAL": levelFatal,
+		"INFO":  slog.LevelInfo,
+		"":      defaultLevel,
+		"bogus": defaultLevel,
+	}
+	for v, want := range cases {
+		if got := levelFromEnv(v); got != want {
+			t.Errorf("levelFromEnv(%q) = %v, want %v", v, got, want)
+		}
+	}
+}
+
+func TestCallerFromEnv(t *testing.T) {
+	cases := map[string]bool{
+		"":      true,
+		"true":  true,
+		"false": false,
+		"1":     true,
+		"0":     false,
+		"nope":  true,
+	}
+	for v, want := range cases {
+		if got := callerFromEnv(v); got != want {
+			t.Errorf("callerFromEnv(%q) = %v, want %v", v, got, want)
+		}
+	}
+}
+
+func TestOutputFromEnv(t *testing.T) {
+	if w := outputFromEnv(""); w != os.Stdout {
+		t.Errorf("outputFromEnv(\"\") = %v, want os.Stdout", w)
+	}
+	if w := outputFromEnv("stderr"); w != os.Stderr {
+		t.Errorf("outputFromEnv(\"stderr\") = %v, want os.Stderr", w)
+	}
+
+	path := filepath.Join(t.TempDir(), "out.log")
+	w := outputFromEnv(path)
+	defer w.Close()
+	if w == os.Stdout || w == os.Stderr {
+		t.Fatalf("outputFromEnv(%q) should open a real file", path)
+	}
+}
+
+func TestNewFromEnvWiresFileCloser(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.log")
+	t.Setenv("LOGGER_OUTPUT", path)
+	defer os.Unsetenv("LOGGER_OUTPUT")
+
+	logger := NewFromEnv()
+	if logger.closer == nil {
+		t.Fatal("expected NewFromEnv to wire the opened file as closer")
+	}
+	if err := logger.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+}
+
+func TestNewFromEnvDoesNotCloseStdout(t *testing.T) {
+	t.Setenv("LOGGER_OUTPUT", "stdout")
+	defer os.Unsetenv("LOGGER_OUTPUT")
+
+	logger := NewFromEnv()
+	if logger.closer != nil {
+		t.Fatal("expected NewFromEnv not to wire stdout as a closer")
+	}
+}
@@ -0,0 +1,132 @@
+package Logger
+
+import (
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// Event is a fluent, field-first alternative to Debugf/Infof/Warnf/
+// Errorf: build up typed fields, then terminate with Debug/Info/Warn/
+// Error to emit. Unlike the *f methods, it doesn't force callers through
+// a format string, and its caller skip count can be raised per event via
+// CallerSkip for wrapper libraries built on top of zlogger.
+type Event struct {
+	logger *Logger
+	fields []Field
+	skip   *int
+}
+
+// Event returns a new fluent Event builder rooted at l.
+func (l *Logger) Event() *Event {
+	return &Event{logger: l}
+}
+
+func (e *Event) with(f Field) *Event {
+	e.fields = append(e.fields, f)
+	return e
+}
+
+func (e *Event) Str(key, val string) *Event {
+	return e.with(Str(key, val))
+}
+
+func (e *Event) Int(key string, val int) *Event {
+	return e.with(Int(key, val))
+}
+
+func (e *Event) Dur(key string, val time.Duration) *Event {
+	return e.with(newField(key, val, func(ev *zerolog.Event) *zerolog.Event { return ev.Dur(key, val) }))
+}
+
+func (e *Event) Any(key string, val any) *Event {
+	return e.with(newField(key, val, func(ev *zerolog.Event) *zerolog.Event { return ev.Interface(key, val) }))
+}
+
+func (e *Event) Err(err error) *Event {
+	return e.with(Err(err))
+}
+
+// CallerSkip skips n additional caller frames for this event alone, on top
+// of the Logger's own skip-frame count, which is left untouched.
+func (e *Event) CallerSkip(n int) *Event {
+	e.skip = &n
+	return e
+}
+
+func (e *Event) emit(level zerolog.Level, msg string) {
+	ev := e.logger.logger.WithLevel(level)
+	if ev == nil {
+		return
+	}
+	if e.skip != nil {
+		ev = ev.CallerSkipFrame(*e.skip)
+	}
+	ev = e.logger.attachSeverity(ev, level)
+	ev = e.logger.attachErr(ev)
+	ev = withHookFields(ev, fieldsToMap(e.fields))
+	for _, f := range e.fields {
+		ev = f.apply(ev)
+	}
+	ev.Msg(msg)
+}
+
+func (e *Event) Debug(msg string) { e.emit(zerolog.DebugLevel, msg) }
+func (e *Event) Info(msg string)  { e.emit(zerolog.InfoLevel, msg) }
+func (e *Event) Warn(msg string)  { e.emit(zerolog.WarnLevel, msg) }
+func (e *Event) Error(msg string) { e.emit(zerolog.ErrorLevel, msg) }
+
+// Field is a typed key/value pair for the no-format Debug/Info/Warn/Error
+// methods and the Event builder, mirroring the mlog/zap field-constructor
+// idiom (log.Str, log.Int, log.Err).
+type Field struct {
+	key   string
+	value any
+	apply func(*zerolog.Event) *zerolog.Event
+}
+
+func newField(key string, value any, apply func(*zerolog.Event) *zerolog.Event) Field {
+	return Field{key: key, value: value, apply: apply}
+}
+
+func Str(key, val string) Field {
+	return newField(key, val, func(e *zerolog.Event) *zerolog.Event { return e.Str(key, val) })
+}
+
+func Int(key string, val int) Field {
+	return newField(key, val, func(e *zerolog.Event) *zerolog.Event { return e.Int(key, val) })
+}
+
+func Err(err error) Field {
+	return newField("error", err, func(e *zerolog.Event) *zerolog.Event { return e.Err(err) })
+}
+
+func fieldsToMap(fields []Field) map[string]any {
+	if len(fields) == 0 {
+		return nil
+	}
+	m := make(map[string]any, len(fields))
+	for _, f := range fields {
+		m[f.key] = f.value
+	}
+	return m
+}
+
+func (l *Logger) emitFields(level zerolog.Level, msg string, fields []Field) {
+	ev := l.logger.WithLevel(level)
+	if ev == nil {
+		return
+	}
+	ev = l.attachSeverity(ev, level)
+	ev = l.attachErr(ev)
+	ev = withHookFields(ev, fieldsToMap(fields))
+	for _, f := range fields {
+		ev = f.apply(ev)
+	}
+	ev.Msg(msg)
+}
+
+func (l *Logger) Debug(msg string, fields ...Field) { l.emitFields(zerolog.DebugLevel, msg, fields) }
+func (l *Logger) Info(msg string, fields ...Field)  { l.emitFields(zerolog.InfoLevel, msg, fields) }
+func (l *Logger) Warn(msg string, fields ...Field)  { l.emitFields(zerolog.WarnLevel, msg, fields) }
+func (l *Logger) Error(msg string, fields ...Field) { l.emitFields(zerolog.ErrorLevel, msg, fields) }
@@ -0,0 +1,81 @@
+package Logger
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+func TestEventBuilderEmitsTypedFields(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewWithCustomLogger(slog.LevelInfo, nil, func(sfc int) zerolog.Context {
+		return zerolog.New(buf).With()
+	})
+
+	logger.Event().
+		Str("k1", "v1").
+		Int("k2", 2).
+		Dur("k3", 5*time.Second).
+		Any("k4", map[string]int{"n": 1}).
+		Info("hi")
+
+	out := buf.String()
+	for _, want := range []string{
+		`"message":"hi"`,
+		`"k1":"v1"`,
+		`"k2":2`,
+		`"k3":5000`,
+		`"k4":{"n":1}`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in output, got %s", want, out)
+		}
+	}
+}
+
+func TestEventHonorsPendingErr(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewWithCustomLogger(slog.LevelInfo, nil, func(sfc int) zerolog.Context {
+		return zerolog.New(buf).With()
+	})
+
+	logger.Err(errors.New("boom")).Event().Info("hi")
+
+	if !strings.Contains(buf.String(), `"error":"boom"`) {
+		t.Fatalf("expected Event() to honor Logger.Err's pending error, got %s", buf.String())
+	}
+}
+
+func TestEventErrField(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewWithCustomLogger(slog.LevelInfo, nil, func(sfc int) zerolog.Context {
+		return zerolog.New(buf).With()
+	})
+
+	logger.Event().Err(errors.New("boom")).Warn("careful")
+
+	if !strings.Contains(buf.String(), `"error":"boom"`) {
+		t.Fatalf("expected Event().Err to attach the error, got %s", buf.String())
+	}
+}
+
+func TestFieldHelpersWithNoFormatMethods(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewWithCustomLogger(slog.LevelInfo, nil, func(sfc int) zerolog.Context {
+		return zerolog.New(buf).With()
+	})
+
+	logger.Info("hi", Str("k1", "v1"), Int("k2", 2), Err(errors.New("boom")))
+
+	out := buf.String()
+	for _, want := range []string{`"message":"hi"`, `"k1":"v1"`, `"k2":2`, `"error":"boom"`} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in output, got %s", want, out)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package Logger
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/rs/zerolog"
+)
+
+// Handler returns a slog.Handler that routes slog.Record attributes into
+// l's zerolog events, so libraries logging through log/slog (e.g.
+// slog.Default()) are captured by zlogger.
+func (l *Logger) Handler() slog.Handler {
+	return &slogHandler{logger: l.logger}
+}
+
+type slogHandler struct {
+	logger *zerolog.Logger
+	prefix string
+}
+
+// Enabled reports against zerolog.GlobalLevel(), the level New and its
+// variants actually filter against via setupLogger's SetGlobalLevel; the
+// per-Logger zerolog.Logger itself is never given its own level.
+func (h *slogHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return zerolog.GlobalLevel() <= slogToZerologLevel(level)
+}
+
+func (h *slogHandler) Handle(_ context.Context, record slog.Record) error {
+	ev := h.logger.WithLevel(slogToZerologLevel(record.Level))
+	if ev == nil {
+		return nil
+	}
+
+	record.Attrs(func(a slog.Attr) bool {
+		h.addAttr(ev, a)
+		return true
+	})
+
+	ev.Msg(record.Message)
+	return nil
+}
+
+func (h *slogHandler) addAttr(ev *zerolog.Event, a slog.Attr) {
+	a.Value = a.Value.Resolve()
+	if a.Value.Kind() == slog.KindGroup {
+		for _, ga := range a.Value.Group() {
+			h.withGroupPrefix(a.Key).addAttr(ev, ga)
+		}
+		return
+	}
+
+	key := h.prefix + a.Key
+	switch a.Value.Kind() {
+	case slog.KindString:
+		ev.Str(key, a.Value.String())
+	case slog.KindInt64:
+		ev.Int64(key, a.Value.Int64())
+	case slog.KindUint64:
+		ev.Uint64(key, a.Value.Uint64())
+	case slog.KindFloat64:
+		ev.Float64(key, a.Value.Float64())
+	case slog.KindBool:
+		ev.Bool(key, a.Value.Bool())
+	case slog.KindDuration:
+		ev.Dur(key, a.Value.Duration())
+	case slog.KindTime:
+		ev.Time(key, a.Value.Time())
+	default:
+		ev.Interface(key, a.Value.Any())
+	}
+}
+
+func (h *slogHandler) withGroupPrefix(name string) *slogHandler {
+	return &slogHandler{logger: h.logger, prefix: h.prefix + name + "."}
+}
+
+func (h *slogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	ctx := h.logger.With()
+	for _, a := range attrs {
+		a.Value = a.Value.Resolve()
+		ctx = ctx.Interface(h.prefix+a.Key, a.Value.Any())
+	}
+	lg := ctx.Logger()
+	return &slogHandler{logger: &lg, prefix: h.prefix}
+}
+
+func (h *slogHandler) WithGroup(name string) slog.Handler {
+	return h.withGroupPrefix(name)
+}
+
+func slogToZerologLevel(level slog.Level) zerolog.Level {
+	switch {
+	case level >= levelFatal:
+		return zerolog.FatalLevel
+	case level >= slog.LevelError:
+		return zerolog.ErrorLevel
+	case level >= slog.LevelWarn:
+		return zerolog.WarnLevel
+	case level >= slog.LevelInfo:
+		return zerolog.InfoLevel
+	default:
+		return zerolog.DebugLevel
+	}
+}
@@ -0,0 +1,39 @@
+package Logger
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+func TestHandlerEnabledReflectsConfiguredLevel(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := newTestLogger(buf) // built at slog.LevelInfo
+	h := logger.Handler()
+
+	if h.Enabled(context.Background(), slog.LevelDebug) {
+		t.Fatal("expected Debug to be disabled for a logger built at Info level")
+	}
+	if !h.Enabled(context.Background(), slog.LevelWarn) {
+		t.Fatal("expected Warn to be enabled for a logger built at Info level")
+	}
+}
+
+func TestHandlerGroupsAndAttrs(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewWithCustomLogger(slog.LevelInfo, nil, func(sfc int) zerolog.Context {
+		return zerolog.New(buf).With()
+	})
+
+	slogger := slog.New(logger.Handler())
+	slogger.WithGroup("req").With("id", 42).Info("handled")
+
+	out := buf.String()
+	if !strings.Contains(out, `"req.id":42`) {
+		t.Fatalf("expected group-prefixed attribute, got %s", out)
+	}
+}
@@ -0,0 +1,127 @@
+package Logger
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+)
+
+// Option configures a Logger at construction time, for use with New and
+// NewWithCustomLogger; the other constructors take no options.
+type Option func(*Logger) *Logger
+
+// WithHooks returns an Option that registers hooks on the Logger being
+// built, in order.
+func WithHooks(hooks ...zerolog.Hook) Option {
+	return func(l *Logger) *Logger {
+		for _, h := range hooks {
+			l = l.AddHook(h)
+		}
+		return l
+	}
+}
+
+// WithSampler returns an Option that attaches sampler to the Logger being
+// built.
+func WithSampler(sampler zerolog.Sampler) Option {
+	return func(l *Logger) *Logger {
+		return l.Sample(sampler)
+	}
+}
+
+// AddHook returns a copy of l with hook registered. Hooks run for every
+// event, in registration order, after Debugf/Infof/Warnf/Errorf/Fatalf's
+// own fields are set.
+func (l *Logger) AddHook(hook zerolog.Hook) *Logger {
+	lg := l.logger.Hook(hook)
+	return &Logger{logger: &lg, closer: l.closer, pendingErr: l.pendingErr, severityMapping: l.severityMapping}
+}
+
+// Sample returns a copy of l that applies sampler to every event, useful
+// for rate-limiting noisy call sites (see BurstSampler).
+func (l *Logger) Sample(sampler zerolog.Sampler) *Logger {
+	lg := l.logger.Sample(sampler)
+	return &Logger{logger: &lg, closer: l.closer, pendingErr: l.pendingErr, severityMapping: l.severityMapping}
+}
+
+// BurstSampler lets call sites rate-limit noisy debug loops without any
+// code changes beyond constructing one with WithSampler. It is a
+// convenience alias for zerolog.BurstSampler.
+type BurstSampler = zerolog.BurstSampler
+
+// SeverityMapping maps a zerolog level to the value written to the
+// "severity" field, e.g. numeric (400/500/800) or GCP Cloud Logging
+// strings ("WARNING"/"ERROR"/"CRITICAL").
+type SeverityMapping map[zerolog.Level]any
+
+// DefaultSeverityMapping reproduces zlogger's original hard-coded
+// severity values.
+var DefaultSeverityMapping = SeverityMapping{
+	zerolog.WarnLevel:  400,
+	zerolog.ErrorLevel: 500,
+	zerolog.FatalLevel: 800,
+}
+
+// GCPSeverityMapping maps to GCP Cloud Logging's severity strings.
+var GCPSeverityMapping = SeverityMapping{
+	zerolog.WarnLevel:  "WARNING",
+	zerolog.ErrorLevel: "ERROR",
+	zerolog.FatalLevel: "CRITICAL",
+}
+
+// WithSeverityMapping returns an Option that replaces the Logger's
+// "severity" field mapping (DefaultSeverityMapping unless overridden),
+// used by Warnf, Errorf and Fatalf. Unlike WithHooks, this replaces the
+// single severity slot instead of appending, so it can't produce
+// duplicate "severity" keys.
+func WithSeverityMapping(mapping SeverityMapping) Option {
+	return func(l *Logger) *Logger {
+		return &Logger{
+			logger:          l.logger,
+			closer:          l.closer,
+			pendingErr:      l.pendingErr,
+			severityMapping: mapping,
+		}
+	}
+}
+
+// ErrorCallback is invoked for every Error/Fatal event when registered via
+// NewErrorCallbackHook, e.g. to forward events to Sentry or an OTel
+// exporter. fields carries the structured fields attached to the event
+// via Errw, Event or the no-format Debug/Info/Warn/Error methods; it is
+// nil for events built with the Debugf/Infof/Warnf/Errorf/Fatalf format-
+// string methods, which have no fields beyond their formatted message.
+type ErrorCallback func(level zerolog.Level, message string, fields map[string]any)
+
+// NewErrorCallbackHook returns a hook that calls fn for every Error and
+// Fatal level event.
+func NewErrorCallbackHook(fn ErrorCallback) zerolog.Hook {
+	return zerolog.HookFunc(func(e *zerolog.Event, level zerolog.Level, message string) {
+		if level != zerolog.ErrorLevel && level != zerolog.FatalLevel {
+			return
+		}
+		fn(level, message, hookFieldsFromEvent(e))
+	})
+}
+
+type hookFieldsCtxKey struct{}
+
+// withHookFields stashes fields on ev via zerolog's Event.Ctx so that a
+// hook installed through NewErrorCallbackHook can recover them in its Run
+// via hookFieldsFromEvent; zerolog gives hooks no other way to see fields
+// already written to an *Event.
+func withHookFields(ev *zerolog.Event, fields map[string]any) *zerolog.Event {
+	if len(fields) == 0 {
+		return ev
+	}
+	return ev.Ctx(context.WithValue(context.Background(), hookFieldsCtxKey{}, fields))
+}
+
+func hookFieldsFromEvent(e *zerolog.Event) map[string]any {
+	ctx := e.GetCtx()
+	if ctx == nil {
+		return nil
+	}
+	fields, _ := ctx.Value(hookFieldsCtxKey{}).(map[string]any)
+	return fields
+}
@@ -0,0 +1,82 @@
+package Logger
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+func newTestLogger(buf *bytes.Buffer, opts ...Option) *Logger {
+	return NewWithCustomLogger(slog.LevelInfo, nil, func(sfc int) zerolog.Context {
+		return zerolog.New(buf).With()
+	}, opts...)
+}
+
+func TestWithSeverityMappingReplacesDefault(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := newTestLogger(buf, WithSeverityMapping(GCPSeverityMapping))
+
+	logger.Errorf("boom")
+
+	out := buf.String()
+	if n := strings.Count(out, `"severity"`); n != 1 {
+		t.Fatalf("expected exactly one severity field, got %d in %s", n, out)
+	}
+	if !strings.Contains(out, `"severity":"ERROR"`) {
+		t.Fatalf("expected GCP severity mapping to apply, got %s", out)
+	}
+}
+
+func TestDefaultSeverityMapping(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := newTestLogger(buf)
+
+	logger.Warnf("careful")
+
+	out := buf.String()
+	if !strings.Contains(out, `"severity":400`) {
+		t.Fatalf("expected default numeric severity, got %s", out)
+	}
+}
+
+func TestErrorCallbackHookReceivesFields(t *testing.T) {
+	buf := &bytes.Buffer{}
+	var gotFields map[string]any
+	var gotMsg string
+	logger := newTestLogger(buf, WithHooks(NewErrorCallbackHook(func(level zerolog.Level, message string, fields map[string]any) {
+		gotMsg = message
+		gotFields = fields
+	})))
+
+	logger.Errw(errors.New("boom"), "failed", map[string]any{"user_id": 42})
+
+	if gotMsg != "failed" {
+		t.Fatalf("expected callback message %q, got %q", "failed", gotMsg)
+	}
+	if gotFields["user_id"] != 42 {
+		t.Fatalf("expected callback fields to include user_id, got %v", gotFields)
+	}
+}
+
+func TestErrorCallbackHookFieldsNilForFormatString(t *testing.T) {
+	buf := &bytes.Buffer{}
+	var gotFields map[string]any
+	called := false
+	logger := newTestLogger(buf, WithHooks(NewErrorCallbackHook(func(level zerolog.Level, message string, fields map[string]any) {
+		called = true
+		gotFields = fields
+	})))
+
+	logger.Errorf("boom")
+
+	if !called {
+		t.Fatalf("expected the error callback to run for Errorf")
+	}
+	if gotFields != nil {
+		t.Fatalf("expected nil fields for a format-string event, got %v", gotFields)
+	}
+}
@@ -0,0 +1,71 @@
+package Logger
+
+import (
+	"log/slog"
+	"os"
+
+	"github.com/rs/zerolog"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// RotationConfig configures a rotating on-disk log file alongside the
+// usual stdout stream.
+type RotationConfig struct {
+	Level             slog.Level
+	SkipFrameCount    *int
+	DisableShowCaller bool
+
+	// Filename is the file to write logs to. Backup log files will be
+	// retained in the same directory.
+	Filename string
+	// MaxSize is the maximum size in megabytes of the log file before it
+	// gets rotated.
+	MaxSize int
+	// MaxBackups is the maximum number of old log files to retain.
+	MaxBackups int
+	// MaxAge is the maximum number of days to retain old log files.
+	MaxAge int
+	// Compress determines if rotated log files should be gzip compressed.
+	Compress bool
+	// LocalTime determines if the time used for formatting the timestamps
+	// in backup files is the computer's local time.
+	LocalTime bool
+}
+
+func (c RotationConfig) writer() *lumberjack.Logger {
+	return &lumberjack.Logger{
+		Filename:   c.Filename,
+		MaxSize:    c.MaxSize,
+		MaxBackups: c.MaxBackups,
+		MaxAge:     c.MaxAge,
+		Compress:   c.Compress,
+		LocalTime:  c.LocalTime,
+	}
+}
+
+// NewWithRotation builds a Logger that fans events out to both stdout and
+// a rotating file sink backed by lumberjack. Call Close on the returned
+// Logger to flush and release the file handle on shutdown.
+func NewWithRotation(cfg RotationConfig) *Logger {
+	fileWriter := cfg.writer()
+	multi := zerolog.MultiLevelWriter(os.Stdout, fileWriter)
+
+	logger := newWithWriter(cfg.Level, cfg.SkipFrameCount, cfg.DisableShowCaller, multi)
+	logger.closer = fileWriter
+	return logger
+}
+
+// NewPrettyLoggerWithRotation is the console-formatted counterpart to
+// NewWithRotation: human-readable output on stdout, structured JSON in
+// the rotating file.
+func NewPrettyLoggerWithRotation(cfg RotationConfig) *Logger {
+	fileWriter := cfg.writer()
+	multi := zerolog.MultiLevelWriter(newConsoleWriter(os.Stdout), fileWriter)
+
+	logger := NewWithCustomLogger(cfg.Level, cfg.SkipFrameCount, func(sfc int) zerolog.Context {
+		return zerolog.New(multi).With().Timestamp()
+	})
+	logger.closer = fileWriter
+	return logger
+}
+
@@ -0,0 +1,81 @@
+package Logger
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNewWithRotationFansOutToStdoutAndFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe: %v", err)
+	}
+	origStdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = origStdout }()
+
+	logger := NewWithRotation(RotationConfig{Filename: path, MaxSize: 1})
+	logger.Infof("hello")
+
+	w.Close()
+	os.Stdout = origStdout
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r); err != nil {
+		t.Fatalf("reading stdout pipe: %v", err)
+	}
+	if !bytes.Contains(buf.Bytes(), []byte(`"message":"hello"`)) {
+		t.Fatalf("expected stdout to receive the event, got %s", buf.String())
+	}
+
+	fileData, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	if !bytes.Contains(fileData, []byte(`"message":"hello"`)) {
+		t.Fatalf("expected the rotating file to receive the event, got %s", fileData)
+	}
+
+	if err := logger.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+}
+
+func TestNewWithRotationRollsOverPastMaxSize(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.log")
+
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatalf("open devnull: %v", err)
+	}
+	defer devNull.Close()
+	origStdout := os.Stdout
+	os.Stdout = devNull
+	defer func() { os.Stdout = origStdout }()
+
+	logger := NewWithRotation(RotationConfig{Filename: path, MaxSize: 1, MaxBackups: 2})
+
+	line := strings.Repeat("x", 1024)
+	for i := 0; i < 1200; i++ { // > MaxSize (1MB) worth of lines, forces a rollover
+		logger.Infof("%s", line)
+	}
+
+	if err := logger.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("ReadDir: %v", err)
+	}
+	if len(entries) < 2 {
+		t.Fatalf("expected rotation past MaxSize to produce a backup file alongside %s, got %v", path, entries)
+	}
+}
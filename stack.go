@@ -0,0 +1,37 @@
+package Logger
+
+import (
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/pkgerrors"
+)
+
+var stackTraceEnabled bool
+
+// EnableStackTrace turns stack-trace enrichment on or off for errors
+// logged via Err, Errorf and Fatalf. When enabled, an error carrying a
+// stack trace (e.g. one created with github.com/pkg/errors) has it
+// attached to the event as a structured "stack" field.
+func EnableStackTrace(enabled bool) {
+	stackTraceEnabled = enabled
+	if enabled {
+		zerolog.ErrorStackMarshaler = pkgerrors.MarshalStack
+	} else {
+		zerolog.ErrorStackMarshaler = nil
+	}
+}
+
+// Errw logs a structured error-level event without the Msgf format-string
+// pitfalls: msg is logged verbatim and fields are attached individually.
+func (l *Logger) Errw(err error, msg string, fields map[string]any) {
+	sub := l
+	if err != nil {
+		sub = l.Err(err)
+	}
+
+	ev := sub.attachErr(sub.attachSeverity(sub.logger.Error(), zerolog.ErrorLevel))
+	ev = withHookFields(ev, fields)
+	for k, v := range fields {
+		ev = ev.Interface(k, v)
+	}
+	ev.Msg(msg)
+}
@@ -0,0 +1,62 @@
+package Logger
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+func TestEnableStackTraceAddsStackField(t *testing.T) {
+	EnableStackTrace(true)
+	defer EnableStackTrace(false)
+
+	buf := &bytes.Buffer{}
+	logger := NewWithCustomLogger(slog.LevelInfo, nil, func(sfc int) zerolog.Context {
+		return zerolog.New(buf).With()
+	})
+
+	logger.Err(errors.New("boom")).Errorf("failed")
+
+	if !strings.Contains(buf.String(), `"stack"`) {
+		t.Fatalf("expected a stack field for a pkg/errors error, got %s", buf.String())
+	}
+}
+
+func TestEnableStackTraceOffOmitsStack(t *testing.T) {
+	EnableStackTrace(false)
+
+	buf := &bytes.Buffer{}
+	logger := NewWithCustomLogger(slog.LevelInfo, nil, func(sfc int) zerolog.Context {
+		return zerolog.New(buf).With()
+	})
+
+	logger.Err(errors.New("boom")).Errorf("failed")
+
+	if strings.Contains(buf.String(), `"stack"`) {
+		t.Fatalf("expected no stack field when stack-trace enrichment is disabled, got %s", buf.String())
+	}
+}
+
+func TestErrwLogsFieldsWithoutFormatString(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewWithCustomLogger(slog.LevelInfo, nil, func(sfc int) zerolog.Context {
+		return zerolog.New(buf).With()
+	})
+
+	logger.Errw(errors.New("boom"), "100% literal message", map[string]any{"user_id": 42})
+
+	out := buf.String()
+	if !strings.Contains(out, `"message":"100% literal message"`) {
+		t.Fatalf("expected Errw to log the message verbatim, got %s", out)
+	}
+	if !strings.Contains(out, `"user_id":42`) {
+		t.Fatalf("expected Errw to attach its fields, got %s", out)
+	}
+	if !strings.Contains(out, `"error":"boom"`) {
+		t.Fatalf("expected Errw to attach the error, got %s", out)
+	}
+}
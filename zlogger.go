@@ -2,6 +2,7 @@ package Logger
 
 import (
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
 	"strings"
@@ -12,10 +13,24 @@ import (
 
 const (
 	defaultSkipFrameCount = 3
+	defaultLevel          = slog.LevelInfo
 )
 
 type Logger struct {
-	logger *zerolog.Logger
+	logger          *zerolog.Logger
+	closer          io.Closer
+	pendingErr      error
+	severityMapping SeverityMapping
+}
+
+// Close flushes and releases any resources held by l, such as a rotating
+// file sink opened via NewWithRotation. It is a no-op for loggers that
+// don't own a closeable resource.
+func (l *Logger) Close() error {
+	if l.closer == nil {
+		return nil
+	}
+	return l.closer.Close()
 }
 
 type ZeroLogger func(int) zerolog.Context
@@ -41,6 +56,8 @@ func setupLogger(level slog.Level, skipFrameCount *int) int {
 	var l zerolog.Level
 
 	switch level {
+	case levelFatal:
+		l = zerolog.FatalLevel
 	case slog.LevelError:
 		l = zerolog.ErrorLevel
 	case slog.LevelWarn:
@@ -57,8 +74,12 @@ func setupLogger(level slog.Level, skipFrameCount *int) int {
 	return sfc
 }
 
-func New(level slog.Level, skipFrameCount *int, disableShowCaller bool) *Logger {
-	zeroContext := zerolog.New(os.Stdout).With().Timestamp()
+func New(level slog.Level, skipFrameCount *int, disableShowCaller bool, opts ...Option) *Logger {
+	return newWithWriter(level, skipFrameCount, disableShowCaller, os.Stdout, opts...)
+}
+
+func newWithWriter(level slog.Level, skipFrameCount *int, disableShowCaller bool, w io.Writer, opts ...Option) *Logger {
+	zeroContext := zerolog.New(w).With().Timestamp()
 	var logger zerolog.Logger
 	if !disableShowCaller {
 		sfc := setupLogger(level, skipFrameCount)
@@ -67,22 +88,27 @@ func New(level slog.Level, skipFrameCount *int, disableShowCaller bool) *Logger
 		logger = zeroContext.Logger()
 	}
 
-	return &Logger{
-		logger: &logger,
-	}
+	l := &Logger{logger: &logger}
+	return applyOptions(l, opts)
 }
 
-func NewWithCustomLogger(level slog.Level, skipFrameCount *int, fn ZeroLogger) *Logger {
+func NewWithCustomLogger(level slog.Level, skipFrameCount *int, fn ZeroLogger, opts ...Option) *Logger {
 	sfc := setupLogger(level, skipFrameCount)
 
 	logger := fn(sfc).Logger()
-	return &Logger{
-		logger: &logger,
+	l := &Logger{logger: &logger}
+	return applyOptions(l, opts)
+}
+
+func applyOptions(l *Logger, opts []Option) *Logger {
+	for _, opt := range opts {
+		l = opt(l)
 	}
+	return l
 }
 
-func NewPrettyLogger(level slog.Level, skipFrameCount *int) *Logger {
-	output := zerolog.ConsoleWriter{Out: os.Stdout, TimeFormat: time.RFC3339}
+func newConsoleWriter(out io.Writer) zerolog.ConsoleWriter {
+	output := zerolog.ConsoleWriter{Out: out, TimeFormat: time.RFC3339}
 	output.FormatLevel = func(i interface{}) string {
 		return strings.ToUpper(fmt.Sprintf("| %-6s|", i))
 	}
@@ -95,6 +121,11 @@ func NewPrettyLogger(level slog.Level, skipFrameCount *int) *Logger {
 	output.FormatFieldValue = func(i interface{}) string {
 		return strings.ToUpper(fmt.Sprintf("%s", i))
 	}
+	return output
+}
+
+func NewPrettyLogger(level slog.Level, skipFrameCount *int) *Logger {
+	output := newConsoleWriter(os.Stdout)
 
 	logger := NewWithCustomLogger(level, skipFrameCount, func(sfc int) zerolog.Context {
 		return zerolog.New(output).With().Timestamp()
@@ -102,31 +133,57 @@ func NewPrettyLogger(level slog.Level, skipFrameCount *int) *Logger {
 	return logger
 }
 
+// Err returns a copy of l that will attach err (and, if stack-trace
+// enrichment is enabled and err carries a stack, a "stack" field) to
+// every Debugf/Infof/Warnf/Errorf/Fatalf call made on it.
 func (l *Logger) Err(err error) *Logger {
-	subLogger := Logger{logger: l.logger}
-	lg := subLogger.logger.With().Err(err).Logger()
-	subLogger.logger = &lg
-	return &subLogger
+	return &Logger{logger: l.logger, closer: l.closer, pendingErr: err, severityMapping: l.severityMapping}
+}
+
+// attachErr applies l's pending error, and its stack trace when enabled,
+// to ev. It must be called before any Msg/Msgf on ev.
+func (l *Logger) attachErr(ev *zerolog.Event) *zerolog.Event {
+	if l.pendingErr == nil {
+		return ev
+	}
+	if stackTraceEnabled {
+		ev = ev.Stack()
+	}
+	return ev.Err(l.pendingErr)
+}
+
+// attachSeverity writes the "severity" field for level using l's
+// severity mapping (DefaultSeverityMapping unless overridden via
+// WithSeverityMapping), to ev. It must be called before any Msg/Msgf.
+func (l *Logger) attachSeverity(ev *zerolog.Event, level zerolog.Level) *zerolog.Event {
+	mapping := l.severityMapping
+	if mapping == nil {
+		mapping = DefaultSeverityMapping
+	}
+	if v, ok := mapping[level]; ok {
+		ev = ev.Interface("severity", v)
+	}
+	return ev
 }
 
 func (l *Logger) Debugf(message string, args ...any) {
-	l.logger.Debug().Msgf(message, args...)
+	l.attachErr(l.logger.Debug()).Msgf(message, args...)
 }
 
 func (l *Logger) Infof(message string, args ...any) {
-	l.logger.Info().Msgf(message, args...)
+	l.attachErr(l.logger.Info()).Msgf(message, args...)
 }
 
 func (l *Logger) Warnf(message string, args ...any) {
-	l.logger.Warn().Int("severity", 400).Msgf(message, args...)
+	l.attachErr(l.attachSeverity(l.logger.Warn(), zerolog.WarnLevel)).Msgf(message, args...)
 }
 
 func (l *Logger) Errorf(message string, args ...any) {
-	l.logger.Error().Int("severity", 500).Msgf(message, args...)
+	l.attachErr(l.attachSeverity(l.logger.Error(), zerolog.ErrorLevel)).Msgf(message, args...)
 }
 
 func (l *Logger) Fatalf(message string, args ...any) {
-	l.logger.Fatal().Int("severity", 800).Msgf(message, args...)
+	l.attachErr(l.attachSeverity(l.logger.Fatal(), zerolog.FatalLevel)).Msgf(message, args...)
 
 	os.Exit(1)
 }